@@ -14,19 +14,105 @@
 
 package models
 
+import (
+	"encoding/json"
+	"strings"
+)
+
 // ErrContractInvalid is a specific error type for handling model validation failures. Type checking within
 // the calling application will facilitate more explicit error handling whereby it's clear that validation
 // has failed as opposed to something unexpected happening.
+//
+// ErrContractInvalid remains comparable with ==. Values without violations are equal when their messages match, while
+// values carrying violations are equal only to copies of themselves since the violations are compared by reference.
 type ErrContractInvalid struct {
 	errMsg string
+	// violations is held behind a pointer so that ErrContractInvalid remains comparable; see the type comment above
+	// for the resulting equality semantics.
+	violations *[]Violation
+}
+
+// Violation describes a single field-level validation failure. Code is a machine-readable identifier such as
+// "required", "out_of_range" or "pattern_mismatch" while Message is intended for humans.
+type Violation struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
 }
 
 // NewErrContractInvalid returns an instance of the error interface with ErrContractInvalid as its implementation.
+// Violations can be added by type asserting the result to ErrContractInvalid and calling AddViolation, or by using
+// NewErrContractInvalidWithViolations instead.
 func NewErrContractInvalid(message string) error {
 	return ErrContractInvalid{errMsg: message}
 }
 
+// NewErrContractInvalidWithViolations returns an ErrContractInvalid carrying the supplied field-level violations.
+// Unlike NewErrContractInvalid it returns the concrete type so that further violations can be added.
+func NewErrContractInvalidWithViolations(message string, violations ...Violation) ErrContractInvalid {
+	e := ErrContractInvalid{errMsg: message}
+	if len(violations) > 0 {
+		v := make([]Violation, len(violations))
+		copy(v, violations)
+		e.violations = &v
+	}
+	return e
+}
+
 // Error fulfills the error interface and returns an error message assembled from the state of ErrContractInvalid.
 func (e ErrContractInvalid) Error() string {
-	return e.errMsg
+	if e.violations == nil || len(*e.violations) == 0 {
+		return e.errMsg
+	}
+
+	details := make([]string, len(*e.violations))
+	for i, v := range *e.violations {
+		if v.Field == "" {
+			details[i] = v.Message
+		} else {
+			details[i] = v.Field + ": " + v.Message
+		}
+	}
+	if e.errMsg == "" {
+		return strings.Join(details, "; ")
+	}
+	return e.errMsg + ": " + strings.Join(details, "; ")
+}
+
+// MarshalJSON implements the Marshaler interface so that the message and any field-level violations are serialized.
+func (e ErrContractInvalid) MarshalJSON() ([]byte, error) {
+	aux := struct {
+		Message    string      `json:"message"`
+		Violations []Violation `json:"violations,omitempty"`
+	}{
+		Message: e.errMsg,
+	}
+	if e.violations != nil {
+		aux.Violations = *e.violations
+	}
+	return json.Marshal(aux)
+}
+
+// AddViolation returns a copy of the ErrContractInvalid with a violation for the given field appended. The receiver is
+// left unmodified. A field may carry more than one violation.
+func (e ErrContractInvalid) AddViolation(field, code, message string) ErrContractInvalid {
+	var existing []Violation
+	if e.violations != nil {
+		existing = *e.violations
+	}
+	violations := make([]Violation, len(existing), len(existing)+1)
+	copy(violations, existing)
+	violations = append(violations, Violation{Field: field, Code: code, Message: message})
+	e.violations = &violations
+	return e
+}
+
+// Violations returns the field-level violations recorded on the ErrContractInvalid in the order they were added.
+func (e ErrContractInvalid) Violations() []Violation {
+	if e.violations == nil {
+		return []Violation{}
+	}
+	violations := make([]Violation, len(*e.violations))
+	copy(violations, *e.violations)
+	return violations
 }
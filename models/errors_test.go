@@ -14,7 +14,11 @@
 
 package models
 
-import "testing"
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
 
 func checkValidationError(err error, expectError bool, testName string, t *testing.T) {
 	if err != nil {
@@ -31,3 +35,106 @@ func checkValidationError(err error, expectError bool, testName string, t *testi
 		}
 	}
 }
+
+func TestErrContractInvalid_Violations(t *testing.T) {
+	base := NewErrContractInvalidWithViolations("device invalid")
+	err := base.
+		AddViolation("name", "required", "name is blank").
+		AddViolation("name", "pattern_mismatch", "name contains illegal characters").
+		AddViolation("port", "out_of_range", "port must be between 1 and 65535")
+
+	expected := []Violation{
+		{Field: "name", Code: "required", Message: "name is blank"},
+		{Field: "name", Code: "pattern_mismatch", Message: "name contains illegal characters"},
+		{Field: "port", Code: "out_of_range", Message: "port must be between 1 and 65535"},
+	}
+	if !reflect.DeepEqual(err.Violations(), expected) {
+		t.Errorf("unexpected violations, expected %v, got %v", expected, err.Violations())
+	}
+	if len(base.Violations()) != 0 {
+		t.Errorf("AddViolation modified the original error: %v", base.Violations())
+	}
+
+	expectedMsg := "device invalid: name: name is blank; name: name contains illegal characters; " +
+		"port: port must be between 1 and 65535"
+	if err.Error() != expectedMsg {
+		t.Errorf("unexpected error message, expected %s, got %s", expectedMsg, err.Error())
+	}
+	if base.Error() != "device invalid" {
+		t.Errorf("unexpected error message without violations: %s", base.Error())
+	}
+	checkValidationError(err, true, "violations", t)
+}
+
+func TestErrContractInvalid_ViolationsJSON(t *testing.T) {
+	err := NewErrContractInvalidWithViolations("device invalid",
+		Violation{Field: "name", Code: "required", Message: "name is blank"})
+
+	data, marshalErr := json.Marshal(err.Violations())
+	if marshalErr != nil {
+		t.Fatalf("unexpected error marshaling violations: %v", marshalErr)
+	}
+	expected := `[{"field":"name","code":"required","message":"name is blank"}]`
+	if string(data) != expected {
+		t.Errorf("unexpected JSON, expected %s, got %s", expected, data)
+	}
+}
+
+func TestErrContractInvalid_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"no violations", NewErrContractInvalid("device invalid"), `{"message":"device invalid"}`},
+		{"violations", NewErrContractInvalidWithViolations("device invalid").
+			AddViolation("name", "required", "name is blank").
+			AddViolation("port", "out_of_range", "port must be between 1 and 65535"),
+			`{"message":"device invalid","violations":[` +
+				`{"field":"name","code":"required","message":"name is blank"},` +
+				`{"field":"port","code":"out_of_range","message":"port must be between 1 and 65535"}]}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.err)
+			if err != nil {
+				t.Fatalf("unexpected error marshaling ErrContractInvalid: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("json.Marshal() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestErrContractInvalid_ViolationWithoutField(t *testing.T) {
+	err := NewErrContractInvalidWithViolations("device invalid").AddViolation("", "required", "body is empty")
+
+	expected := "device invalid: body is empty"
+	if err.Error() != expected {
+		t.Errorf("unexpected error message, expected %s, got %s", expected, err.Error())
+	}
+}
+
+func TestErrContractInvalid_Comparable(t *testing.T) {
+	sentinel := NewErrContractInvalid("x")
+	if NewErrContractInvalid("x") != sentinel {
+		t.Errorf("expected errors with the same message to compare equal")
+	}
+
+	withViolations := NewErrContractInvalidWithViolations("x").AddViolation("name", "required", "name is blank")
+	if withViolations == sentinel {
+		t.Errorf("expected error with violations to differ from sentinel")
+	}
+	if copied := withViolations; copied != withViolations {
+		t.Errorf("expected a copy of an error with violations to compare equal")
+	}
+	v := Violation{Field: "name", Code: "required", Message: "name is blank"}
+	if NewErrContractInvalidWithViolations("x", v) == NewErrContractInvalidWithViolations("x", v) {
+		t.Errorf("expected separately built errors carrying violations to compare unequal")
+	}
+	errs := map[error]bool{sentinel: true, withViolations: true}
+	if len(errs) != 2 {
+		t.Errorf("expected two distinct map keys, got %d", len(errs))
+	}
+}